@@ -0,0 +1,88 @@
+package utils
+
+import "reflect"
+
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// Append returns a new slice consisting of the elements of to followed by the
+// elements of from. If to and every element of from are themselves slices
+// with an element type assignable to that of to, reflect.AppendSlice is used
+// so the result keeps its concrete type, e.g. []string in, []string out. A
+// from element that is a scalar assignable to that element type is appended
+// as a single element the same way. If to is nil or an empty []interface{},
+// the element type is instead inferred from from, unpacking any typed slice
+// in from element-wise rather than nesting it.
+// If any element does not fit that shape, every element is promoted to
+// interface{} and the result is a []interface{} instead of an error.
+func Append(to interface{}, from ...interface{}) (interface{}, error) {
+	toValue, elemType, ok := typedSlice(to)
+	if !ok {
+		return appendAny(to, from...), nil
+	}
+
+	result := toValue
+	for _, f := range from {
+		fromValue := reflect.ValueOf(f)
+
+		if fromValue.IsValid() && fromValue.Kind() == reflect.Slice && fromValue.Type().Elem().AssignableTo(elemType) {
+			result = reflect.AppendSlice(result, fromValue)
+			continue
+		}
+
+		if fromValue.IsValid() && fromValue.Type().AssignableTo(elemType) {
+			result = reflect.Append(result, fromValue)
+			continue
+		}
+
+		return appendAny(to, from...), nil
+	}
+
+	return result.Interface(), nil
+}
+
+// typedSlice reports whether to is a slice whose element type can be used to
+// drive a type-preserving Append. A nil to, or an empty []interface{}, is
+// treated as having no usable element type yet.
+func typedSlice(to interface{}) (reflect.Value, reflect.Type, bool) {
+	toValue := reflect.ValueOf(to)
+	if !toValue.IsValid() || toValue.Kind() != reflect.Slice {
+		return reflect.Value{}, nil, false
+	}
+
+	elemType := toValue.Type().Elem()
+	if elemType == interfaceType && toValue.Len() == 0 {
+		return reflect.Value{}, nil, false
+	}
+
+	return toValue, elemType, true
+}
+
+// appendAny promotes to and every element of from to interface{}, unpacking
+// any slice element-wise, and returns the resulting []interface{}.
+func appendAny(to interface{}, from ...interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	result = appendUnpacked(result, to)
+
+	for _, f := range from {
+		result = appendUnpacked(result, f)
+	}
+
+	return result
+}
+
+func appendUnpacked(result []interface{}, v interface{}) []interface{} {
+	value := reflect.ValueOf(v)
+	if value.IsValid() && value.Kind() == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			result = append(result, value.Index(i).Interface())
+		}
+
+		return result
+	}
+
+	if v == nil {
+		return result
+	}
+
+	return append(result, v)
+}
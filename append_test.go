@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAppendTypePreserving(t *testing.T) {
+	got, err := Append([]string{"a", "b"}, []string{"c", "d"})
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(slice, slice) = %v (%T), want %v", got, got, want)
+	}
+
+	got, err = Append([]int{1, 2}, 3, 4)
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(slice, scalars...) = %v (%T), want %v", got, got, want)
+	}
+}
+
+func TestAppendIncompatibleTypeFallback(t *testing.T) {
+	got, err := Append([]int{1, 2}, "not an int")
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, "not an int"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(incompatible type) = %v (%T), want %v", got, got, want)
+	}
+
+	got, err = Append([]int{1, 2}, []string{"x"})
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, "x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(incompatible slice) = %v (%T), want %v", got, got, want)
+	}
+}
+
+func TestAppendNilAndEmptyInterfaceUnpack(t *testing.T) {
+	got, err := Append(nil, []string{"a", "b"}, "c")
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(nil, ...) = %v (%T), want %v", got, got, want)
+	}
+
+	got, err = Append([]interface{}{}, []int{1, 2}, 3)
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(empty []interface{}, ...) = %v (%T), want %v", got, got, want)
+	}
+
+	got, err = Append(nil, nil)
+	if err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if want := []interface{}{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Append(nil, nil) = %v (%T), want %v", got, got, want)
+	}
+}
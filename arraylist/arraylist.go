@@ -6,24 +6,59 @@ import (
 	"reflect"
 )
 
-type ArrayList struct {
-	slice []interface{}
+// ListT is a generic, resizable list backed by a slice of T.
+type ListT[T any] struct {
+	slice []T
 }
 
-// New returns a new *ArrayList
-func New() *ArrayList {
-	return new(ArrayList)
+// NewT returns a new *ListT[T]
+func NewT[T any]() *ListT[T] {
+	return new(ListT[T])
 }
 
 // Add appends the specified elements to the end of this list.
-func (a *ArrayList) Add(objs ...interface{}) {
+func (a *ListT[T]) Add(objs ...T) {
 	a.slice = append(a.slice, objs...)
 }
 
+// AppendSlice appends other to this list. If other is a slice, its elements
+// are appended individually; otherwise other itself is appended as a single
+// element. It returns NotAssignableErr if other, or one of its elements, is
+// not assignable to T.
+func (a *ListT[T]) AppendSlice(other interface{}) error {
+	otherValue := reflect.ValueOf(other)
+	if !otherValue.IsValid() {
+		return nil
+	}
+
+	if otherValue.Kind() != reflect.Slice {
+		obj, ok := other.(T)
+		if !ok {
+			return notAssignableErr(other)
+		}
+
+		a.Add(obj)
+		return nil
+	}
+
+	objs := make([]T, otherValue.Len())
+	for i := range objs {
+		obj, ok := otherValue.Index(i).Interface().(T)
+		if !ok {
+			return notAssignableErr(otherValue.Index(i).Interface())
+		}
+
+		objs[i] = obj
+	}
+
+	a.Add(objs...)
+	return nil
+}
+
 // AddAt inserts the specified elements at the specified position in this list.
 // If pos is more than the list size or less than 0, then index out of range
 // error is returned. Nil otherwise.
-func (a *ArrayList) AddAt(pos int, objs ...interface{}) error {
+func (a *ListT[T]) AddAt(pos int, objs ...T) error {
 	if err := a.checkRangeForAddAt(pos); err != nil {
 		return err
 	}
@@ -43,21 +78,23 @@ func (a *ArrayList) AddAt(pos int, objs ...interface{}) error {
 }
 
 // AddFirst inserts the specified elements to the beginning of this list.
-func (a *ArrayList) AddFirst(objs ...interface{}) {
+func (a *ListT[T]) AddFirst(objs ...T) {
 	a.slice = append(objs, a.slice...)
 }
 
 // Clear removes all of the elements from this list.
-func (a *ArrayList) Clear() {
+func (a *ListT[T]) Clear() {
 	a.slice = nil
 }
 
 // Get returns the element at the specified position in this list.
-// It returns the element at the specified position if exists, otherwise returns nil.
-// Can return index out of range error.
-func (a *ArrayList) Get(pos int) (interface{}, error) {
+// It returns the element at the specified position if exists, otherwise returns the
+// zero value of T. Can return index out of range error.
+func (a *ListT[T]) Get(pos int) (T, error) {
+	var zero T
+
 	if err := a.checkRange(pos); err != nil {
-		return nil, indexOutOfRangeErr(pos, a.Size())
+		return zero, indexOutOfRangeErr(pos, a.Size())
 	}
 
 	return a.slice[pos], nil
@@ -65,7 +102,7 @@ func (a *ArrayList) Get(pos int) (interface{}, error) {
 
 // IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
 // It can return -1 if this list does not contain the specified element.
-func (a *ArrayList) IndexOf(obj interface{}) int {
+func (a *ListT[T]) IndexOf(obj T) int {
 	for i, o := range a.slice {
 		if reflect.DeepEqual(o, obj) {
 			return i
@@ -76,13 +113,13 @@ func (a *ArrayList) IndexOf(obj interface{}) int {
 }
 
 // IsEmpty returns true if this list containes no elements.
-func (a *ArrayList) IsEmpty() bool {
+func (a *ListT[T]) IsEmpty() bool {
 	return a.Size() == 0
 }
 
 // LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
 // It can return -1 if this list does not contain the specified element.
-func (a *ArrayList) LastIndexOf(obj interface{}) int {
+func (a *ListT[T]) LastIndexOf(obj T) int {
 	for i := a.Size() - 1; i > -1; i-- {
 		if o := a.slice[i]; reflect.DeepEqual(o, obj) {
 			return i
@@ -94,7 +131,7 @@ func (a *ArrayList) LastIndexOf(obj interface{}) int {
 
 // Remove removes the first occurrence of the specified element from this list.
 // If element not found, it returns an element not found error.
-func (a *ArrayList) Remove(obj interface{}) error {
+func (a *ListT[T]) Remove(obj T) error {
 	for i, o := range a.slice {
 		if reflect.DeepEqual(o, obj) {
 			return a.RemoveAt(i)
@@ -106,32 +143,33 @@ func (a *ArrayList) Remove(obj interface{}) error {
 
 // RemoveAt removes the element at the specified position (0-based) in this list.
 // It can return index out of range error.
-func (a *ArrayList) RemoveAt(pos int) error {
+func (a *ListT[T]) RemoveAt(pos int) error {
 	if err := a.checkRange(pos); err != nil {
 		return err
 	}
 
-	a.slice[pos] = nil
+	var zero T
+	a.slice[pos] = zero
 	a.slice = append(a.slice[:pos], a.slice[pos+1:]...)
 	return nil
 }
 
 // Size returns the number of elements in this list.
-func (a *ArrayList) Size() int {
+func (a *ListT[T]) Size() int {
 	return len(a.slice)
 }
 
 // Slice returns a slice containing all of the elements in this list.
 // To avoid references, the returned slice is a copy of this list.
-func (a *ArrayList) Slice() []interface{} {
-	return append([]interface{}{}, a.slice...)
+func (a *ListT[T]) Slice() []T {
+	return append([]T{}, a.slice...)
 }
 
-func (a *ArrayList) addAt(pos int, elements ...interface{}) {
-	a.slice = append(append(append([]interface{}{}, a.slice[:pos]...), elements...), a.slice[pos:]...)
+func (a *ListT[T]) addAt(pos int, elements ...T) {
+	a.slice = append(append(append([]T{}, a.slice[:pos]...), elements...), a.slice[pos:]...)
 }
 
-func (a *ArrayList) checkRangeForAddAt(pos int) error {
+func (a *ListT[T]) checkRangeForAddAt(pos int) error {
 	if pos > a.Size() || pos < 0 {
 		return indexOutOfRangeErr(pos, a.Size())
 	}
@@ -139,7 +177,7 @@ func (a *ArrayList) checkRangeForAddAt(pos int) error {
 	return nil
 }
 
-func (a *ArrayList) checkRange(pos int) error {
+func (a *ListT[T]) checkRange(pos int) error {
 	if pos > a.Size()-1 || pos < 0 {
 		return indexOutOfRangeErr(pos, a.Size())
 	}
@@ -147,6 +185,105 @@ func (a *ArrayList) checkRange(pos int) error {
 	return nil
 }
 
+// ArrayList is a reflection-based, type-erased list kept for callers that
+// predate generics support in this module.
+//
+// Deprecated: use ListT[T] instead.
+type ArrayList struct {
+	list *ListT[interface{}]
+}
+
+// New returns a new *ArrayList
+//
+// Deprecated: use NewT[T]() instead.
+func New() *ArrayList {
+	return &ArrayList{list: NewT[interface{}]()}
+}
+
+// Add appends the specified elements to the end of this list.
+//
+// Deprecated: use ListT[T].Add instead.
+func (a *ArrayList) Add(objs ...interface{}) {
+	a.list.Add(objs...)
+}
+
+// AddAt inserts the specified elements at the specified position in this list.
+//
+// Deprecated: use ListT[T].AddAt instead.
+func (a *ArrayList) AddAt(pos int, objs ...interface{}) error {
+	return a.list.AddAt(pos, objs...)
+}
+
+// AddFirst inserts the specified elements to the beginning of this list.
+//
+// Deprecated: use ListT[T].AddFirst instead.
+func (a *ArrayList) AddFirst(objs ...interface{}) {
+	a.list.AddFirst(objs...)
+}
+
+// Clear removes all of the elements from this list.
+//
+// Deprecated: use ListT[T].Clear instead.
+func (a *ArrayList) Clear() {
+	a.list.Clear()
+}
+
+// Get returns the element at the specified position in this list.
+//
+// Deprecated: use ListT[T].Get instead.
+func (a *ArrayList) Get(pos int) (interface{}, error) {
+	return a.list.Get(pos)
+}
+
+// IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
+//
+// Deprecated: use ListT[T].IndexOf instead.
+func (a *ArrayList) IndexOf(obj interface{}) int {
+	return a.list.IndexOf(obj)
+}
+
+// IsEmpty returns true if this list containes no elements.
+//
+// Deprecated: use ListT[T].IsEmpty instead.
+func (a *ArrayList) IsEmpty() bool {
+	return a.list.IsEmpty()
+}
+
+// LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
+//
+// Deprecated: use ListT[T].LastIndexOf instead.
+func (a *ArrayList) LastIndexOf(obj interface{}) int {
+	return a.list.LastIndexOf(obj)
+}
+
+// Remove removes the first occurrence of the specified element from this list.
+//
+// Deprecated: use ListT[T].Remove instead.
+func (a *ArrayList) Remove(obj interface{}) error {
+	return a.list.Remove(obj)
+}
+
+// RemoveAt removes the element at the specified position (0-based) in this list.
+//
+// Deprecated: use ListT[T].RemoveAt instead.
+func (a *ArrayList) RemoveAt(pos int) error {
+	return a.list.RemoveAt(pos)
+}
+
+// Size returns the number of elements in this list.
+//
+// Deprecated: use ListT[T].Size instead.
+func (a *ArrayList) Size() int {
+	return a.list.Size()
+}
+
+// Slice returns a slice containing all of the elements in this list.
+//
+// Deprecated: use ListT[T].Slice instead.
+func (a *ArrayList) Slice() []interface{} {
+	return a.list.Slice()
+}
+
 func elementNotFoundErr(obj interface{}) error {
 	errStr := fmt.Sprintf("%v element was not found in this list.", obj)
 	return errors.New(errStr)
@@ -156,3 +293,8 @@ func indexOutOfRangeErr(pos, listSize int) error {
 	errStr := fmt.Sprintf("Index %d is out of range from a list size of %d", pos, listSize)
 	return errors.New(errStr)
 }
+
+func notAssignableErr(obj interface{}) error {
+	errStr := fmt.Sprintf("%v is not assignable to this list's element type.", obj)
+	return errors.New(errStr)
+}
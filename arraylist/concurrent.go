@@ -0,0 +1,295 @@
+package arraylist
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncArrayList is a concurrency-safe ListT[T] guarded by a sync.RWMutex.
+// Readers (Get, IndexOf, LastIndexOf, IsEmpty, Size, Slice) take an RLock;
+// mutators (Add, AddAt, AddFirst, Remove, RemoveAt, Clear) take a Lock.
+type SyncArrayList[T any] struct {
+	mu   sync.RWMutex
+	list *ListT[T]
+}
+
+// NewSync returns a new *SyncArrayList[T].
+func NewSync[T any]() *SyncArrayList[T] {
+	return &SyncArrayList[T]{list: NewT[T]()}
+}
+
+// Add appends the specified elements to the end of this list.
+func (a *SyncArrayList[T]) Add(objs ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.list.Add(objs...)
+}
+
+// AddAt inserts the specified elements at the specified position in this list.
+func (a *SyncArrayList[T]) AddAt(pos int, objs ...T) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.list.AddAt(pos, objs...)
+}
+
+// AddFirst inserts the specified elements to the beginning of this list.
+func (a *SyncArrayList[T]) AddFirst(objs ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.list.AddFirst(objs...)
+}
+
+// Clear removes all of the elements from this list.
+func (a *SyncArrayList[T]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.list.Clear()
+}
+
+// Get returns the element at the specified position in this list.
+func (a *SyncArrayList[T]) Get(pos int) (T, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.Get(pos)
+}
+
+// IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
+func (a *SyncArrayList[T]) IndexOf(obj T) int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.IndexOf(obj)
+}
+
+// IsEmpty returns true if this list containes no elements.
+func (a *SyncArrayList[T]) IsEmpty() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.IsEmpty()
+}
+
+// LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
+func (a *SyncArrayList[T]) LastIndexOf(obj T) int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.LastIndexOf(obj)
+}
+
+// Remove removes the first occurrence of the specified element from this list.
+func (a *SyncArrayList[T]) Remove(obj T) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.list.Remove(obj)
+}
+
+// RemoveAt removes the element at the specified position (0-based) in this list.
+func (a *SyncArrayList[T]) RemoveAt(pos int) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.list.RemoveAt(pos)
+}
+
+// Size returns the number of elements in this list.
+func (a *SyncArrayList[T]) Size() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.Size()
+}
+
+// Slice returns a slice containing all of the elements in this list.
+func (a *SyncArrayList[T]) Slice() []T {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list.Slice()
+}
+
+// COWArrayList is a copy-on-write list: reads are wait-free, served from an
+// atomic.Value holding the current backing slice, while writes clone that
+// slice under a mutex before publishing the new one. This avoids the race a
+// Slice() copy followed by separate Get() calls can hit against a list that
+// is mutated concurrently.
+type COWArrayList[T any] struct {
+	mu    sync.Mutex
+	slice atomic.Value
+}
+
+// NewCOW returns a new *COWArrayList[T].
+func NewCOW[T any]() *COWArrayList[T] {
+	a := &COWArrayList[T]{}
+	a.slice.Store([]T{})
+	return a
+}
+
+// Add appends the specified elements to the end of this list.
+func (a *COWArrayList[T]) Add(objs ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cur := a.current()
+	next := make([]T, 0, len(cur)+len(objs))
+	next = append(next, cur...)
+	next = append(next, objs...)
+	a.slice.Store(next)
+}
+
+// AddAt inserts the specified elements at the specified position in this list.
+// If pos is more than the list size or less than 0, then index out of range
+// error is returned. Nil otherwise.
+func (a *COWArrayList[T]) AddAt(pos int, objs ...T) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cur := a.current()
+	if pos > len(cur) || pos < 0 {
+		return indexOutOfRangeErr(pos, len(cur))
+	}
+
+	next := make([]T, 0, len(cur)+len(objs))
+	next = append(next, cur[:pos]...)
+	next = append(next, objs...)
+	next = append(next, cur[pos:]...)
+	a.slice.Store(next)
+	return nil
+}
+
+// AddFirst inserts the specified elements to the beginning of this list.
+func (a *COWArrayList[T]) AddFirst(objs ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cur := a.current()
+	next := make([]T, 0, len(cur)+len(objs))
+	next = append(next, objs...)
+	next = append(next, cur...)
+	a.slice.Store(next)
+}
+
+// Clear removes all of the elements from this list.
+func (a *COWArrayList[T]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.slice.Store([]T{})
+}
+
+// Get returns the element at the specified position in this list.
+func (a *COWArrayList[T]) Get(pos int) (T, error) {
+	var zero T
+
+	cur := a.current()
+	if pos < 0 || pos > len(cur)-1 {
+		return zero, indexOutOfRangeErr(pos, len(cur))
+	}
+
+	return cur[pos], nil
+}
+
+// IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
+func (a *COWArrayList[T]) IndexOf(obj T) int {
+	cur := a.current()
+	for i, o := range cur {
+		if reflect.DeepEqual(o, obj) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IsEmpty returns true if this list containes no elements.
+func (a *COWArrayList[T]) IsEmpty() bool {
+	return a.Size() == 0
+}
+
+// LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
+func (a *COWArrayList[T]) LastIndexOf(obj T) int {
+	cur := a.current()
+	for i := len(cur) - 1; i > -1; i-- {
+		if reflect.DeepEqual(cur[i], obj) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Remove removes the first occurrence of the specified element from this list.
+// If element not found, it returns an element not found error.
+func (a *COWArrayList[T]) Remove(obj T) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cur := a.current()
+	idx := -1
+	for i, o := range cur {
+		if reflect.DeepEqual(o, obj) {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		return elementNotFoundErr(obj)
+	}
+
+	next := make([]T, 0, len(cur)-1)
+	next = append(next, cur[:idx]...)
+	next = append(next, cur[idx+1:]...)
+	a.slice.Store(next)
+	return nil
+}
+
+// RemoveAt removes the element at the specified position (0-based) in this list.
+// It can return index out of range error.
+func (a *COWArrayList[T]) RemoveAt(pos int) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cur := a.current()
+	if pos < 0 || pos > len(cur)-1 {
+		return indexOutOfRangeErr(pos, len(cur))
+	}
+
+	next := make([]T, 0, len(cur)-1)
+	next = append(next, cur[:pos]...)
+	next = append(next, cur[pos+1:]...)
+	a.slice.Store(next)
+	return nil
+}
+
+// Size returns the number of elements in this list.
+func (a *COWArrayList[T]) Size() int {
+	return len(a.current())
+}
+
+// Slice returns a copy of the current backing slice.
+func (a *COWArrayList[T]) Slice() []T {
+	return a.Snapshot()
+}
+
+// Snapshot returns a point-in-time copy of this list's elements. The backing
+// slice is only ever replaced, never mutated in place, so Snapshot never
+// observes a half-written state, even without holding a lock.
+func (a *COWArrayList[T]) Snapshot() []T {
+	cur := a.current()
+	return append([]T{}, cur...)
+}
+
+// Iterator returns a function that calls yield once for each element of a
+// consistent snapshot of this list, stopping early if yield returns false.
+// Because it ranges over a snapshot taken up front, it is unaffected by
+// concurrent mutations and never needs to hold a lock.
+func (a *COWArrayList[T]) Iterator() func(yield func(obj T) bool) {
+	snapshot := a.Snapshot()
+
+	return func(yield func(obj T) bool) {
+		for _, v := range snapshot {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (a *COWArrayList[T]) current() []T {
+	return a.slice.Load().([]T)
+}
@@ -0,0 +1,84 @@
+package arraylist
+
+import (
+	"sync"
+	"testing"
+)
+
+// concurrentList is the subset of SyncArrayList[int] and COWArrayList[int]
+// exercised by TestConcurrentAddGetRemove.
+type concurrentList interface {
+	Add(objs ...int)
+	Get(pos int) (int, error)
+	Remove(obj int) error
+	Size() int
+	Slice() []int
+}
+
+func TestConcurrentAddGetRemove(t *testing.T) {
+	constructors := map[string]func() concurrentList{
+		"SyncArrayList": func() concurrentList { return NewSync[int]() },
+		"COWArrayList":  func() concurrentList { return NewCOW[int]() },
+	}
+
+	for name, newList := range constructors {
+		newList := newList
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+
+			const n = 100
+			var wg sync.WaitGroup
+
+			// Concurrently add n distinct values while other goroutines read
+			// Size and Get against the list being built.
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					list.Add(i)
+				}(i)
+			}
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if size := list.Size(); size < 0 || size > n {
+						t.Errorf("Size() = %d during concurrent Add, want in [0, %d]", size, n)
+					}
+					list.Get(0)
+				}()
+			}
+			wg.Wait()
+
+			if got := list.Size(); got != n {
+				t.Fatalf("Size() = %d after concurrent Add, want %d", got, n)
+			}
+
+			seen := make(map[int]bool, n)
+			for _, v := range list.Slice() {
+				seen[v] = true
+			}
+			for i := 0; i < n; i++ {
+				if !seen[i] {
+					t.Errorf("value %d missing after concurrent Add", i)
+				}
+			}
+
+			// Concurrently remove every value.
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					if err := list.Remove(i); err != nil {
+						t.Errorf("Remove(%d) returned error: %v", i, err)
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			if got := list.Size(); got != 0 {
+				t.Fatalf("Size() = %d after concurrent Remove, want 0", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+)
+
+var (
+	NilReduceFuncErr  = errors.New("reduce function is nil.")
+	NilKeyFuncErr     = errors.New("key function is nil.")
+	NilLessFuncErr    = errors.New("less function is nil.")
+	NilCompareFuncErr = errors.New("compare function is nil.")
+	NilNumberFuncErr  = errors.New("number function is nil.")
+	InvalidSizeErr    = errors.New("size must be greater than zero.")
+)
+
+// ReduceFunc is the function to be called by Reduce.
+// It receives the accumulated value and each element of the collection, and
+// returns the new accumulated value.
+type ReduceFunc func(acc interface{}, obj interface{}) interface{}
+
+// KeyFunc is the function to be called by GroupBy.
+// It receives each element of the collection and returns the key under which
+// the element will be grouped.
+type KeyFunc func(obj interface{}) interface{}
+
+// LessFunc is the function to be called by SortBy.
+// It receives two elements of the collection and returns true if the first
+// element should sort before the second.
+type LessFunc func(a, b interface{}) bool
+
+// CompareFunc is the function to be called by Min and Max.
+// It receives two elements of the collection and returns a negative number if a
+// sorts before b, zero if they are equal, and a positive number if a sorts after b.
+type CompareFunc func(a, b interface{}) int
+
+// NumberFunc is the function to be called by Sum.
+// It receives each element of the collection and returns the numeric value
+// that will be accumulated.
+type NumberFunc func(obj interface{}) float64
+
+// Reduce calls the specified reduceFunc once for each element in the collection,
+// threading the accumulated value returned by the previous call (or initial, for
+// the first call) through to the next, and returns the final accumulated value.
+// If collection is not a slice, then NotSliceErr is returned.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+func Reduce(collection interface{}, initial interface{}, reduceFunc ReduceFunc) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	acc := initial
+	for i := 0; i < collectionValue.Len(); i++ {
+		acc = reduceFunc(acc, collectionValue.Index(i).Interface())
+	}
+
+	return acc, nil
+}
+
+// GroupBy calls the specified keyFunc once for each element in the collection
+// and returns a map of the keys returned by keyFunc to the slice of elements
+// that produced that key.
+// If collection is not a slice, then NotSliceErr is returned.
+// If keyFunc is nil, then NilKeyFuncErr is returned.
+func GroupBy(collection interface{}, keyFunc KeyFunc) (map[interface{}][]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if keyFunc == nil {
+		return nil, NilKeyFuncErr
+	}
+
+	groups := make(map[interface{}][]interface{})
+	for i := 0; i < collectionValue.Len(); i++ {
+		obj := collectionValue.Index(i).Interface()
+		key := keyFunc(obj)
+		groups[key] = append(groups[key], obj)
+	}
+
+	return groups, nil
+}
+
+// Uniq returns a copy of the specified collection with duplicate elements removed,
+// keeping the first occurrence of each element. Elements are compared with
+// reflect.DeepEqual.
+// If collection is not a slice, then NotSliceErr is returned.
+func Uniq(collection interface{}) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	uniq := make([]interface{}, 0, collectionValue.Len())
+	for i := 0; i < collectionValue.Len(); i++ {
+		obj := collectionValue.Index(i).Interface()
+
+		exists := false
+		for _, u := range uniq {
+			if reflect.DeepEqual(u, obj) {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			uniq = append(uniq, obj)
+		}
+	}
+
+	return uniq, nil
+}
+
+// Flatten returns a new slice with nested slice elements flattened up to the
+// specified depth. A depth of 0 returns a shallow copy of collection.
+// If collection is not a slice, then NotSliceErr is returned.
+func Flatten(collection interface{}, depth int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	return flatten(collectionValue, depth), nil
+}
+
+// Zip returns a slice of tuples formed by pairing up the elements at the same
+// index from each of the given slices. The result is truncated to the length
+// of the shortest slice.
+// If any of slices is not itself a slice, then NotSliceErr is returned.
+func Zip(slices ...interface{}) ([][]interface{}, error) {
+	values := make([]reflect.Value, len(slices))
+	minLen := -1
+
+	for i, s := range slices {
+		sliceValue := reflect.ValueOf(s)
+		if sliceValue.Kind() != reflect.Slice {
+			return make([][]interface{}, 0), NotSliceErr
+		}
+
+		values[i] = sliceValue
+		if minLen == -1 || sliceValue.Len() < minLen {
+			minLen = sliceValue.Len()
+		}
+	}
+
+	if minLen == -1 {
+		minLen = 0
+	}
+
+	zipped := make([][]interface{}, minLen)
+	for i := 0; i < minLen; i++ {
+		tuple := make([]interface{}, len(values))
+		for j, v := range values {
+			tuple[j] = v.Index(i).Interface()
+		}
+
+		zipped[i] = tuple
+	}
+
+	return zipped, nil
+}
+
+// Partition calls the specified selectFunc once for each element in the
+// collection and splits the collection into the elements for which selectFunc
+// returned true (pass) and the elements for which it returned false (fail).
+// If collection is not a slice, then NotSliceErr is returned.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func Partition(collection interface{}, selectFunc SelectFunc) (pass []interface{}, fail []interface{}, err error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), make([]interface{}, 0), NotSliceErr
+	}
+
+	if selectFunc == nil {
+		return make([]interface{}, 0), make([]interface{}, 0), NilSelectFuncErr
+	}
+
+	pass = make([]interface{}, 0, collectionValue.Len())
+	fail = make([]interface{}, 0, collectionValue.Len())
+
+	for i := 0; i < collectionValue.Len(); i++ {
+		obj := collectionValue.Index(i).Interface()
+		if selectFunc(obj) {
+			pass = append(pass, obj)
+		} else {
+			fail = append(fail, obj)
+		}
+	}
+
+	return pass, fail, nil
+}
+
+// Chunk splits collection into consecutive subslices of length size. The last
+// chunk may be shorter than size if collection does not divide evenly.
+// If collection is not a slice, then NotSliceErr is returned.
+// If size is less than 1, then InvalidSizeErr is returned.
+func Chunk(collection interface{}, size int) ([][]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([][]interface{}, 0), NotSliceErr
+	}
+
+	if size < 1 {
+		return make([][]interface{}, 0), InvalidSizeErr
+	}
+
+	chunks := make([][]interface{}, 0, (collectionValue.Len()+size-1)/size)
+	for i := 0; i < collectionValue.Len(); i += size {
+		end := i + size
+		if end > collectionValue.Len() {
+			end = collectionValue.Len()
+		}
+
+		chunk := make([]interface{}, 0, end-i)
+		for j := i; j < end; j++ {
+			chunk = append(chunk, collectionValue.Index(j).Interface())
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+// Reverse returns a copy of collection with the order of its elements reversed.
+// If collection is not a slice, then NotSliceErr is returned.
+func Reverse(collection interface{}) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	n := collectionValue.Len()
+	reversed := make([]interface{}, n)
+	for i := 0; i < n; i++ {
+		reversed[n-1-i] = collectionValue.Index(i).Interface()
+	}
+
+	return reversed, nil
+}
+
+// SortBy returns a copy of collection sorted according to lessFunc.
+// If collection is not a slice, then NotSliceErr is returned.
+// If lessFunc is nil, then NilLessFuncErr is returned.
+func SortBy(collection interface{}, lessFunc LessFunc) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if lessFunc == nil {
+		return make([]interface{}, 0), NilLessFuncErr
+	}
+
+	sorted := make([]interface{}, collectionValue.Len())
+	for i := range sorted {
+		sorted[i] = collectionValue.Index(i).Interface()
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return lessFunc(sorted[i], sorted[j]) })
+	return sorted, nil
+}
+
+// First returns the first n elements of collection. If n is greater than the
+// length of collection, the whole collection is returned. If n is negative, an
+// empty slice is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+func First(collection interface{}, n int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	end := clamp(n, collectionValue.Len())
+	first := make([]interface{}, end)
+	for i := 0; i < end; i++ {
+		first[i] = collectionValue.Index(i).Interface()
+	}
+
+	return first, nil
+}
+
+// Last returns the last n elements of collection. If n is greater than the
+// length of collection, the whole collection is returned. If n is negative, an
+// empty slice is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+func Last(collection interface{}, n int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	count := clamp(n, collectionValue.Len())
+	start := collectionValue.Len() - count
+	last := make([]interface{}, count)
+	for i := 0; i < count; i++ {
+		last[i] = collectionValue.Index(start + i).Interface()
+	}
+
+	return last, nil
+}
+
+// Take is an alias for First, returning the first n elements of collection.
+// If collection is not a slice, then NotSliceErr is returned.
+func Take(collection interface{}, n int) ([]interface{}, error) {
+	return First(collection, n)
+}
+
+// Drop returns collection without its first n elements. If n is greater than
+// the length of collection, an empty slice is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+func Drop(collection interface{}, n int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	start := clamp(n, collectionValue.Len())
+	drop := make([]interface{}, 0, collectionValue.Len()-start)
+	for i := start; i < collectionValue.Len(); i++ {
+		drop = append(drop, collectionValue.Index(i).Interface())
+	}
+
+	return drop, nil
+}
+
+// Min returns the smallest element of collection according to compareFunc.
+// If collection is not a slice, then NotSliceErr is returned.
+// If compareFunc is nil, then NilCompareFuncErr is returned.
+// If collection is empty, then ElemNotFoundErr is returned.
+func Min(collection interface{}, compareFunc CompareFunc) (interface{}, error) {
+	return extremum(collection, compareFunc, -1)
+}
+
+// Max returns the largest element of collection according to compareFunc.
+// If collection is not a slice, then NotSliceErr is returned.
+// If compareFunc is nil, then NilCompareFuncErr is returned.
+// If collection is empty, then ElemNotFoundErr is returned.
+func Max(collection interface{}, compareFunc CompareFunc) (interface{}, error) {
+	return extremum(collection, compareFunc, 1)
+}
+
+// Sum returns the sum of the numeric values extracted from each element of
+// collection by numberFunc.
+// If collection is not a slice, then NotSliceErr is returned.
+// If numberFunc is nil, then NilNumberFuncErr is returned.
+func Sum(collection interface{}, numberFunc NumberFunc) (float64, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return 0, NotSliceErr
+	}
+
+	if numberFunc == nil {
+		return 0, NilNumberFuncErr
+	}
+
+	var sum float64
+	for i := 0; i < collectionValue.Len(); i++ {
+		sum += numberFunc(collectionValue.Index(i).Interface())
+	}
+
+	return sum, nil
+}
+
+func flatten(collectionValue reflect.Value, depth int) []interface{} {
+	flat := make([]interface{}, 0, collectionValue.Len())
+	for i := 0; i < collectionValue.Len(); i++ {
+		item := collectionValue.Index(i).Interface()
+		itemValue := reflect.ValueOf(item)
+
+		if depth > 0 && itemValue.Kind() == reflect.Slice {
+			flat = append(flat, flatten(itemValue, depth-1)...)
+		} else {
+			flat = append(flat, item)
+		}
+	}
+
+	return flat
+}
+
+func extremum(collection interface{}, compareFunc CompareFunc, want int) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if compareFunc == nil {
+		return nil, NilCompareFuncErr
+	}
+
+	if collectionValue.Len() == 0 {
+		return nil, ElemNotFoundErr
+	}
+
+	best := collectionValue.Index(0).Interface()
+	for i := 1; i < collectionValue.Len(); i++ {
+		obj := collectionValue.Index(i).Interface()
+		if sign(compareFunc(obj, best)) == want {
+			best = obj
+		}
+	}
+
+	return best, nil
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func clamp(n, max int) int {
+	if n < 0 {
+		return 0
+	}
+
+	if n > max {
+		return max
+	}
+
+	return n
+}
@@ -0,0 +1,305 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	got, err := Reduce([]int{1, 2, 3}, 0, func(acc, obj interface{}) interface{} { return acc.(int) + obj.(int) })
+	if err != nil {
+		t.Fatalf("Reduce returned error: %v", err)
+	}
+	if got != 6 {
+		t.Errorf("Reduce() = %v, want 6", got)
+	}
+
+	if _, err := Reduce("not a slice", 0, func(acc, obj interface{}) interface{} { return acc }); err != NotSliceErr {
+		t.Errorf("Reduce(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Reduce([]int{1}, 0, nil); err != NilReduceFuncErr {
+		t.Errorf("Reduce(nil reduceFunc) error = %v, want NilReduceFuncErr", err)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	got, err := GroupBy([]int{1, 2, 3, 4}, func(obj interface{}) interface{} { return obj.(int) % 2 })
+	if err != nil {
+		t.Fatalf("GroupBy returned error: %v", err)
+	}
+
+	want := map[interface{}][]interface{}{
+		0: {2, 4},
+		1: {1, 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+
+	if _, err := GroupBy("not a slice", func(obj interface{}) interface{} { return obj }); err != NotSliceErr {
+		t.Errorf("GroupBy(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := GroupBy([]int{1}, nil); err != NilKeyFuncErr {
+		t.Errorf("GroupBy(nil keyFunc) error = %v, want NilKeyFuncErr", err)
+	}
+}
+
+func TestUniq(t *testing.T) {
+	got, err := Uniq([]int{1, 2, 2, 3, 1})
+	if err != nil {
+		t.Fatalf("Uniq returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Uniq() = %v, want %v", got, want)
+	}
+
+	if _, err := Uniq("not a slice"); err != NotSliceErr {
+		t.Errorf("Uniq(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	collection := []interface{}{1, []interface{}{2, []interface{}{3, 4}}}
+
+	got, err := Flatten(collection, 0)
+	if err != nil {
+		t.Fatalf("Flatten returned error: %v", err)
+	}
+	if want := []interface{}{1, []interface{}{2, []interface{}{3, 4}}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten(depth=0) = %v, want %v", got, want)
+	}
+
+	got, err = Flatten(collection, 2)
+	if err != nil {
+		t.Fatalf("Flatten returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten(depth=2) = %v, want %v", got, want)
+	}
+
+	if _, err := Flatten("not a slice", 1); err != NotSliceErr {
+		t.Errorf("Flatten(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestZip(t *testing.T) {
+	got, err := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("Zip returned error: %v", err)
+	}
+
+	want := [][]interface{}{{1, "a"}, {2, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+
+	if _, err := Zip([]int{1}, "not a slice"); err != NotSliceErr {
+		t.Errorf("Zip(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	pass, fail, err := Partition([]int{1, 2, 3, 4}, func(obj interface{}) bool { return obj.(int)%2 == 0 })
+	if err != nil {
+		t.Fatalf("Partition returned error: %v", err)
+	}
+
+	if wantPass := []interface{}{2, 4}; !reflect.DeepEqual(pass, wantPass) {
+		t.Errorf("Partition() pass = %v, want %v", pass, wantPass)
+	}
+	if wantFail := []interface{}{1, 3}; !reflect.DeepEqual(fail, wantFail) {
+		t.Errorf("Partition() fail = %v, want %v", fail, wantFail)
+	}
+
+	if _, _, err := Partition("not a slice", func(obj interface{}) bool { return true }); err != NotSliceErr {
+		t.Errorf("Partition(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, _, err := Partition([]int{1}, nil); err != NilSelectFuncErr {
+		t.Errorf("Partition(nil selectFunc) error = %v, want NilSelectFuncErr", err)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	got, err := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	if err != nil {
+		t.Fatalf("Chunk returned error: %v", err)
+	}
+	if want := [][]interface{}{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunk() = %v, want %v", got, want)
+	}
+
+	if _, err := Chunk("not a slice", 2); err != NotSliceErr {
+		t.Errorf("Chunk(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Chunk([]int{1}, 0); err != InvalidSizeErr {
+		t.Errorf("Chunk(size=0) error = %v, want InvalidSizeErr", err)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	got, err := Reverse([]int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Reverse returned error: %v", err)
+	}
+	if want := []interface{}{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse() = %v, want %v", got, want)
+	}
+
+	if _, err := Reverse("not a slice"); err != NotSliceErr {
+		t.Errorf("Reverse(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	got, err := SortBy([]int{3, 1, 2}, func(a, b interface{}) bool { return a.(int) < b.(int) })
+	if err != nil {
+		t.Fatalf("SortBy returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortBy() = %v, want %v", got, want)
+	}
+
+	if _, err := SortBy("not a slice", func(a, b interface{}) bool { return true }); err != NotSliceErr {
+		t.Errorf("SortBy(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := SortBy([]int{1}, nil); err != NilLessFuncErr {
+		t.Errorf("SortBy(nil lessFunc) error = %v, want NilLessFuncErr", err)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	got, err := First([]int{1, 2, 3}, 2)
+	if err != nil {
+		t.Fatalf("First returned error: %v", err)
+	}
+	if want := []interface{}{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("First() = %v, want %v", got, want)
+	}
+
+	got, err = First([]int{1, 2, 3}, 10)
+	if err != nil {
+		t.Fatalf("First returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("First(n > len) = %v, want %v", got, want)
+	}
+
+	got, err = First([]int{1, 2, 3}, -1)
+	if err != nil {
+		t.Fatalf("First returned error: %v", err)
+	}
+	if want := []interface{}{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("First(n < 0) = %v, want %v", got, want)
+	}
+
+	if _, err := First("not a slice", 1); err != NotSliceErr {
+		t.Errorf("First(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestLast(t *testing.T) {
+	got, err := Last([]int{1, 2, 3}, 2)
+	if err != nil {
+		t.Fatalf("Last returned error: %v", err)
+	}
+	if want := []interface{}{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Last() = %v, want %v", got, want)
+	}
+
+	if _, err := Last("not a slice", 1); err != NotSliceErr {
+		t.Errorf("Last(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestTake(t *testing.T) {
+	got, err := Take([]int{1, 2, 3}, 2)
+	if err != nil {
+		t.Fatalf("Take returned error: %v", err)
+	}
+	if want := []interface{}{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Take() = %v, want %v", got, want)
+	}
+}
+
+func TestDrop(t *testing.T) {
+	got, err := Drop([]int{1, 2, 3}, 1)
+	if err != nil {
+		t.Fatalf("Drop returned error: %v", err)
+	}
+	if want := []interface{}{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Drop() = %v, want %v", got, want)
+	}
+
+	got, err = Drop([]int{1, 2, 3}, 10)
+	if err != nil {
+		t.Fatalf("Drop returned error: %v", err)
+	}
+	if want := []interface{}{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Drop(n > len) = %v, want %v", got, want)
+	}
+
+	if _, err := Drop("not a slice", 1); err != NotSliceErr {
+		t.Errorf("Drop(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func compareInts(a, b interface{}) int { return a.(int) - b.(int) }
+
+func TestMin(t *testing.T) {
+	got, err := Min([]int{3, 1, 2}, compareInts)
+	if err != nil {
+		t.Fatalf("Min returned error: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("Min() = %v, want 1", got)
+	}
+
+	if _, err := Min("not a slice", compareInts); err != NotSliceErr {
+		t.Errorf("Min(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Min([]int{1}, nil); err != NilCompareFuncErr {
+		t.Errorf("Min(nil compareFunc) error = %v, want NilCompareFuncErr", err)
+	}
+
+	if _, err := Min([]int{}, compareInts); err != ElemNotFoundErr {
+		t.Errorf("Min(empty) error = %v, want ElemNotFoundErr", err)
+	}
+}
+
+func TestMax(t *testing.T) {
+	got, err := Max([]int{3, 1, 2}, compareInts)
+	if err != nil {
+		t.Fatalf("Max returned error: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("Max() = %v, want 3", got)
+	}
+
+	if _, err := Max([]int{}, compareInts); err != ElemNotFoundErr {
+		t.Errorf("Max(empty) error = %v, want ElemNotFoundErr", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	got, err := Sum([]int{1, 2, 3}, func(obj interface{}) float64 { return float64(obj.(int)) })
+	if err != nil {
+		t.Fatalf("Sum returned error: %v", err)
+	}
+	if got != 6 {
+		t.Errorf("Sum() = %v, want 6", got)
+	}
+
+	if _, err := Sum("not a slice", func(obj interface{}) float64 { return 0 }); err != NotSliceErr {
+		t.Errorf("Sum(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Sum([]int{1}, nil); err != NilNumberFuncErr {
+		t.Errorf("Sum(nil numberFunc) error = %v, want NilNumberFuncErr", err)
+	}
+}
@@ -0,0 +1,309 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+)
+
+// Permutation returns all k-length permutations of the elements in collection,
+// without repeating the same source element within a single permutation.
+// If collection is not a slice, then NotSliceErr is returned.
+// If k is negative or greater than the number of elements in collection, then
+// InvalidSizeErr is returned.
+func Permutation(collection interface{}, k int) ([]interface{}, error) {
+	elems, err := sliceElems(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if k < 0 || k > len(elems) {
+		return make([]interface{}, 0), InvalidSizeErr
+	}
+
+	permutations := make([]interface{}, 0)
+	used := make([]bool, len(elems))
+	current := make([]interface{}, k)
+
+	var backtrack func(depth int)
+	backtrack = func(depth int) {
+		if depth == k {
+			permutations = append(permutations, append([]interface{}{}, current...))
+			return
+		}
+
+		for i := range elems {
+			if used[i] {
+				continue
+			}
+
+			used[i] = true
+			current[depth] = elems[i]
+			backtrack(depth + 1)
+			used[i] = false
+		}
+	}
+
+	backtrack(0)
+	return permutations, nil
+}
+
+// CombinationsK returns all k-element subsets of collection, i.e. its
+// k-combinations, in the order their indices are chosen ascending.
+// If collection is not a slice, then NotSliceErr is returned.
+// If k is negative or greater than the number of elements in collection, then
+// InvalidSizeErr is returned.
+func CombinationsK(collection interface{}, k int) ([]interface{}, error) {
+	elems, err := sliceElems(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if k < 0 || k > len(elems) {
+		return make([]interface{}, 0), InvalidSizeErr
+	}
+
+	combinations := make([]interface{}, 0)
+	current := make([]interface{}, k)
+
+	var choose func(start, depth int)
+	choose = func(start, depth int) {
+		if depth == k {
+			combinations = append(combinations, append([]interface{}{}, current...))
+			return
+		}
+
+		for i := start; i < len(elems); i++ {
+			current[depth] = elems[i]
+			choose(i+1, depth+1)
+		}
+	}
+
+	choose(0, 0)
+	return combinations, nil
+}
+
+// PermutationChan streams the k-length permutations of collection one at a
+// time on the returned channel instead of materializing them all upfront,
+// honoring ctx cancellation. The channel is closed once every permutation has
+// been sent or ctx is done.
+func PermutationChan(ctx context.Context, collection interface{}, k int) <-chan []interface{} {
+	out := make(chan []interface{})
+
+	go func() {
+		defer close(out)
+
+		elems, err := sliceElems(collection)
+		if err != nil || k < 0 || k > len(elems) {
+			return
+		}
+
+		used := make([]bool, len(elems))
+		current := make([]interface{}, k)
+
+		var backtrack func(depth int) bool
+		backtrack = func(depth int) bool {
+			if depth == k {
+				select {
+				case out <- append([]interface{}{}, current...):
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			}
+
+			for i := range elems {
+				if used[i] {
+					continue
+				}
+
+				used[i] = true
+				current[depth] = elems[i]
+				ok := backtrack(depth + 1)
+				used[i] = false
+
+				if !ok {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		backtrack(0)
+	}()
+
+	return out
+}
+
+// CombinationChan streams the Cartesian-product combinations of slices one at
+// a time on the returned channel instead of materializing them all upfront,
+// honoring ctx cancellation. The channel is closed once every combination has
+// been sent or ctx is done.
+func CombinationChan(ctx context.Context, slices ...interface{}) <-chan []interface{} {
+	out := make(chan []interface{})
+
+	go func() {
+		defer close(out)
+
+		values := make([]reflect.Value, len(slices))
+		for i, s := range slices {
+			sliceValue := reflect.ValueOf(s)
+			if sliceValue.Kind() != reflect.Slice {
+				return
+			}
+
+			values[i] = sliceValue
+		}
+
+		current := make([]interface{}, len(values))
+
+		var combine func(depth int) bool
+		combine = func(depth int) bool {
+			if depth == len(values) {
+				select {
+				case out <- append([]interface{}{}, current...):
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			}
+
+			for i := 0; i < values[depth].Len(); i++ {
+				current[depth] = values[depth].Index(i).Interface()
+				if !combine(depth + 1) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		combine(0)
+	}()
+
+	return out
+}
+
+// Permutator pulls successive permutations of a fixed collection one at a
+// time, using the standard non-recursive lexicographic next-permutation
+// algorithm (find the largest i with a[i] < a[i+1], swap it with the smallest
+// a[j] > a[i] to its right, then reverse the suffix after i) so huge
+// permutation spaces can be enumerated without allocating them all at once.
+type Permutator struct {
+	elems   []interface{}
+	indices []int
+	started bool
+	done    bool
+}
+
+// NewPermutator returns a *Permutator over every permutation of collection.
+// If collection is not a slice, then NotSliceErr is returned.
+func NewPermutator(collection interface{}) (*Permutator, error) {
+	elems, err := sliceElems(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, len(elems))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	return &Permutator{elems: elems, indices: indices}, nil
+}
+
+// Next returns the next permutation and true, or (nil, false) once every
+// permutation has been produced.
+func (p *Permutator) Next() ([]interface{}, bool) {
+	if p.done {
+		return nil, false
+	}
+
+	if !p.started {
+		p.started = true
+		return p.current(), true
+	}
+
+	if !nextPermutation(p.indices) {
+		p.done = true
+		return nil, false
+	}
+
+	return p.current(), true
+}
+
+// NextN returns up to n further permutations, stopping early once every
+// permutation has been produced. A negative n returns no permutations.
+func (p *Permutator) NextN(n int) [][]interface{} {
+	if n < 0 {
+		n = 0
+	}
+
+	result := make([][]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		perm, ok := p.Next()
+		if !ok {
+			break
+		}
+
+		result = append(result, perm)
+	}
+
+	return result
+}
+
+func (p *Permutator) current() []interface{} {
+	perm := make([]interface{}, len(p.indices))
+	for i, idx := range p.indices {
+		perm[i] = p.elems[idx]
+	}
+
+	return perm
+}
+
+// nextPermutation rearranges indices into the lexicographically next
+// permutation in place and reports whether one existed. Starting from indices
+// sorted ascending and repeatedly calling nextPermutation visits every
+// permutation exactly once in lexicographic order.
+func nextPermutation(indices []int) bool {
+	n := len(indices)
+	if n < 2 {
+		return false
+	}
+
+	i := n - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	j := n - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+
+	indices[i], indices[j] = indices[j], indices[i]
+
+	for l, r := i+1, n-1; l < r; l, r = l+1, r-1 {
+		indices[l], indices[r] = indices[r], indices[l]
+	}
+
+	return true
+}
+
+func sliceElems(collection interface{}) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	elems := make([]interface{}, collectionValue.Len())
+	for i := range elems {
+		elems[i] = collectionValue.Index(i).Interface()
+	}
+
+	return elems, nil
+}
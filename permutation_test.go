@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestPermutationChanMatchesPermutation(t *testing.T) {
+	collection := []int{1, 2, 3}
+
+	for k := 0; k <= len(collection); k++ {
+		want, err := Permutation(collection, k)
+		if err != nil {
+			t.Fatalf("Permutation(%v, %d) returned error: %v", collection, k, err)
+		}
+
+		got := make([]interface{}, 0, len(want))
+		for perm := range PermutationChan(context.Background(), collection, k) {
+			got = append(got, perm)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("PermutationChan(%v, %d) = %v, want %v", collection, k, got, want)
+		}
+	}
+}
+
+func TestPermutatorMatchesPermutation(t *testing.T) {
+	collection := []string{"a", "b", "c"}
+
+	want, err := Permutation(collection, len(collection))
+	if err != nil {
+		t.Fatalf("Permutation returned error: %v", err)
+	}
+
+	p, err := NewPermutator(collection)
+	if err != nil {
+		t.Fatalf("NewPermutator returned error: %v", err)
+	}
+
+	got := make([]interface{}, 0, len(want))
+	for {
+		perm, ok := p.Next()
+		if !ok {
+			break
+		}
+
+		got = append(got, perm)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Permutator produced %v, want %v", got, want)
+	}
+}
+
+func TestPermutatorNextN(t *testing.T) {
+	p, err := NewPermutator([]int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("NewPermutator returned error: %v", err)
+	}
+
+	if got := p.NextN(-1); len(got) != 0 {
+		t.Errorf("NextN(-1) = %v, want empty", got)
+	}
+
+	all := p.NextN(100)
+	if len(all) != 6 {
+		t.Errorf("NextN(100) returned %d permutations, want 6", len(all))
+	}
+
+	if got := p.NextN(1); len(got) != 0 {
+		t.Errorf("NextN after exhaustion = %v, want empty", got)
+	}
+}
@@ -45,14 +45,7 @@ func Compact(collection interface{}) ([]interface{}, error) {
 		return make([]interface{}, 0), NotSliceErr
 	}
 
-	compact := make([]interface{}, 0, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		if item := collectionValue.Index(i).Interface(); item != nil {
-			compact = append(compact, item)
-		}
-	}
-
-	return compact, nil
+	return From(collection).Select(func(obj interface{}) bool { return obj != nil }).ToSlice()
 }
 
 // IsIncluded returns true if the specified element is present in the specified collection, otherwise returns false.
@@ -64,10 +57,13 @@ func IsIncluded(collection interface{}, obj interface{}) (bool, error) {
 		return false, NotSliceErr
 	}
 
-	for i := 0; i < collectionValue.Len(); i++ {
-		if item := collectionValue.Index(i).Interface(); reflect.DeepEqual(item, obj) {
-			return true, nil
-		}
+	s := make([]interface{}, collectionValue.Len())
+	for i := range s {
+		s[i] = collectionValue.Index(i).Interface()
+	}
+
+	if IsIncludedT(s, obj, func(a, b interface{}) bool { return reflect.DeepEqual(a, b) }) {
+		return true, nil
 	}
 
 	return false, ElemNotFoundErr
@@ -87,12 +83,7 @@ func Map(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
 		return make([]interface{}, 0), NilMapFuncErr
 	}
 
-	newColl := make([]interface{}, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		newColl[i] = mapFunc(collectionValue.Index(i).Interface())
-	}
-
-	return newColl, nil
+	return From(collection).Map(mapFunc).ToSlice()
 }
 
 // Select calls the specified selectFunc once for each element in the collection.
@@ -109,14 +100,67 @@ func Select(collection interface{}, selectFunc SelectFunc) ([]interface{}, error
 		return make([]interface{}, 0), NilSelectFuncErr
 	}
 
-	newColl := make([]interface{}, 0, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		if obj := collectionValue.Index(i).Interface(); selectFunc(obj) {
-			newColl = append(newColl, obj)
+	return From(collection).Select(selectFunc).ToSlice()
+}
+
+// MapT calls f once for each element of s and returns a new slice containing the
+// values returned by f. Unlike Map, the element and result types are known at
+// compile time, so no reflection is involved.
+func MapT[T, U any](s []T, f func(T) U) []U {
+	newSlice := make([]U, len(s))
+	for i, v := range s {
+		newSlice[i] = f(v)
+	}
+
+	return newSlice
+}
+
+// SelectT calls pred once for each element of s and returns a new slice containing
+// all elements of s for which pred returns true.
+func SelectT[T any](s []T, pred func(T) bool) []T {
+	newSlice := make([]T, 0, len(s))
+	for _, v := range s {
+		if pred(v) {
+			newSlice = append(newSlice, v)
 		}
 	}
 
-	return newColl, nil
+	return newSlice
+}
+
+// CompactT returns a copy of s with all zero-value elements removed.
+func CompactT[T comparable](s []T) []T {
+	var zero T
+
+	compact := make([]T, 0, len(s))
+	for _, v := range s {
+		if v != zero {
+			compact = append(compact, v)
+		}
+	}
+
+	return compact
+}
+
+// IsIncludedT returns true if x is present in s according to eq, otherwise false.
+func IsIncludedT[T any](s []T, x T, eq func(a, b T) bool) bool {
+	for _, v := range s {
+		if eq(v, x) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CombinationT returns a slice of all combinations of elements from the given
+// slices, preserving their element type T.
+func CombinationT[T any](slices ...[]T) [][]T {
+	combinations := make([][]T, 0)
+	singleCombination := make([]T, len(slices))
+
+	combinationT(singleCombination, &combinations, 0, slices)
+	return combinations
 }
 
 func combination(singleCombination []interface{}, combinations *[]interface{}, depthLevel int, slicesValue *reflect.Value) error {
@@ -139,3 +183,17 @@ func combination(singleCombination []interface{}, combinations *[]interface{}, d
 
 	return nil
 }
+
+func combinationT[T any](singleCombination []T, combinations *[][]T, depthLevel int, slices [][]T) {
+	slice := slices[depthLevel]
+
+	for i := 0; i < len(slice); i++ {
+		singleCombination[depthLevel] = slice[i]
+
+		if nextLevel := depthLevel + 1; nextLevel < len(slices) {
+			combinationT(singleCombination, combinations, nextLevel, slices)
+		} else {
+			*combinations = append(*combinations, append([]T{}, singleCombination...))
+		}
+	}
+}
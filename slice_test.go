@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got, err := Map([]int{1, 2, 3}, func(obj interface{}) interface{} { return obj.(int) * 2 })
+	if err != nil {
+		t.Fatalf("Map returned error: %v", err)
+	}
+	if want := []interface{}{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+
+	if _, err := Map("not a slice", func(obj interface{}) interface{} { return obj }); err != NotSliceErr {
+		t.Errorf("Map(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Map([]int{1}, nil); err != NilMapFuncErr {
+		t.Errorf("Map(nil mapFunc) error = %v, want NilMapFuncErr", err)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	got, err := Select([]int{1, 2, 3, 4}, func(obj interface{}) bool { return obj.(int)%2 == 0 })
+	if err != nil {
+		t.Fatalf("Select returned error: %v", err)
+	}
+	if want := []interface{}{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Select() = %v, want %v", got, want)
+	}
+
+	if _, err := Select("not a slice", func(obj interface{}) bool { return true }); err != NotSliceErr {
+		t.Errorf("Select(non-slice) error = %v, want NotSliceErr", err)
+	}
+
+	if _, err := Select([]int{1}, nil); err != NilSelectFuncErr {
+		t.Errorf("Select(nil selectFunc) error = %v, want NilSelectFuncErr", err)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	got, err := Compact([]interface{}{1, nil, 2, nil, 3})
+	if err != nil {
+		t.Fatalf("Compact returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Compact() = %v, want %v", got, want)
+	}
+
+	if _, err := Compact("not a slice"); err != NotSliceErr {
+		t.Errorf("Compact(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestIsIncluded(t *testing.T) {
+	ok, err := IsIncluded([]int{1, 2, 3}, 2)
+	if err != nil || !ok {
+		t.Errorf("IsIncluded(present) = (%v, %v), want (true, nil)", ok, err)
+	}
+
+	ok, err = IsIncluded([]int{1, 2, 3}, 5)
+	if ok || err != ElemNotFoundErr {
+		t.Errorf("IsIncluded(absent) = (%v, %v), want (false, ElemNotFoundErr)", ok, err)
+	}
+
+	if _, err := IsIncluded("not a slice", 1); err != NotSliceErr {
+		t.Errorf("IsIncluded(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestCombination(t *testing.T) {
+	got, err := Combination([]interface{}{1, 2}, []interface{}{"a", "b"})
+	if err != nil {
+		t.Fatalf("Combination returned error: %v", err)
+	}
+
+	want := []interface{}{
+		[]interface{}{1, "a"},
+		[]interface{}{1, "b"},
+		[]interface{}{2, "a"},
+		[]interface{}{2, "b"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Combination() = %v, want %v", got, want)
+	}
+
+	if _, err := Combination("not a slice"); err != NotSliceErr {
+		t.Errorf("Combination(non-slice element) error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestMapT(t *testing.T) {
+	got := MapT([]int{1, 2, 3}, func(n int) string {
+		switch n {
+		case 1:
+			return "one"
+		case 2:
+			return "two"
+		default:
+			return "other"
+		}
+	})
+
+	if want := []string{"one", "two", "other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapT() = %v, want %v", got, want)
+	}
+}
+
+func TestSelectT(t *testing.T) {
+	got := SelectT([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 })
+	if want := []int{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectT() = %v, want %v", got, want)
+	}
+}
+
+func TestCompactT(t *testing.T) {
+	got := CompactT([]int{1, 0, 2, 0, 3})
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompactT() = %v, want %v", got, want)
+	}
+}
+
+func TestIsIncludedT(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	if !IsIncludedT([]int{1, 2, 3}, 2, eq) {
+		t.Error("IsIncludedT(present) = false, want true")
+	}
+
+	if IsIncludedT([]int{1, 2, 3}, 5, eq) {
+		t.Error("IsIncludedT(absent) = true, want false")
+	}
+}
+
+func TestCombinationT(t *testing.T) {
+	got := CombinationT([]int{1, 2}, []int{10, 20})
+	want := [][]int{{1, 10}, {1, 20}, {2, 10}, {2, 20}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CombinationT() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,376 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Stream is a lazy, chainable pipeline over a collection. Intermediate stages
+// such as Map, Select, Take, Drop, FlatMap and Uniq are fused together: the
+// source is walked exactly once and nothing is materialized until a terminal
+// call such as ToSlice, ForEach, Reduce, Count or First. Sort is the one
+// exception, since it must see every upstream element before it can emit any.
+//
+// A Stream is meant to be consumed by a single terminal call; building more
+// stages on top of one that already ran is safe, but re-running the same
+// terminal call twice re-executes every stage from the source.
+type Stream struct {
+	valid       bool
+	pull        func(sink func(obj interface{}) bool)
+	parallelism int
+}
+
+// From returns a new *Stream over collection.
+// If collection is not a slice, every terminal operation on the returned
+// stream returns NotSliceErr.
+func From(collection interface{}) *Stream {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return &Stream{valid: false}
+	}
+
+	return &Stream{
+		valid: true,
+		pull: func(sink func(obj interface{}) bool) {
+			n := collectionValue.Len()
+			for i := 0; i < n; i++ {
+				if !sink(collectionValue.Index(i).Interface()) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Parallel makes the next Map or Select stage fan its callback out across n
+// goroutines, collecting results back in original order through a bounded
+// channel. It is useful when those callbacks are expensive. Other stages are
+// unaffected and remain sequential.
+func (s *Stream) Parallel(n int) *Stream {
+	return &Stream{valid: s.valid, pull: s.pull, parallelism: n}
+}
+
+// Map appends a stage that transforms every element reaching it with mapFunc.
+func (s *Stream) Map(mapFunc MapFunc) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull, parallelism := s.pull, s.parallelism
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		if parallelism > 1 {
+			parallelStage(upstreamPull, parallelism, func(obj interface{}) (interface{}, bool) {
+				return mapFunc(obj), true
+			}, sink)
+			return
+		}
+
+		upstreamPull(func(obj interface{}) bool {
+			return sink(mapFunc(obj))
+		})
+	}}
+}
+
+// Select appends a stage that forwards only the elements for which
+// selectFunc returns true.
+func (s *Stream) Select(selectFunc SelectFunc) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull, parallelism := s.pull, s.parallelism
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		if parallelism > 1 {
+			parallelStage(upstreamPull, parallelism, func(obj interface{}) (interface{}, bool) {
+				return obj, selectFunc(obj)
+			}, sink)
+			return
+		}
+
+		upstreamPull(func(obj interface{}) bool {
+			if !selectFunc(obj) {
+				return true
+			}
+			return sink(obj)
+		})
+	}}
+}
+
+// FlatMap appends a stage that maps each element reaching it to a slice with
+// flatMapFunc and forwards each of its elements in turn.
+func (s *Stream) FlatMap(flatMapFunc func(obj interface{}) []interface{}) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull := s.pull
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		upstreamPull(func(obj interface{}) bool {
+			for _, o := range flatMapFunc(obj) {
+				if !sink(o) {
+					return false
+				}
+			}
+
+			return true
+		})
+	}}
+}
+
+// Take appends a stage that forwards only the first n elements reaching it
+// and then stops pulling further elements from upstream.
+func (s *Stream) Take(n int) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull := s.pull
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		remaining := n
+		upstreamPull(func(obj interface{}) bool {
+			if remaining <= 0 {
+				return false
+			}
+
+			remaining--
+			return sink(obj)
+		})
+	}}
+}
+
+// Drop appends a stage that discards the first n elements reaching it and
+// forwards the rest.
+func (s *Stream) Drop(n int) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull := s.pull
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		skipped := 0
+		upstreamPull(func(obj interface{}) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+
+			return sink(obj)
+		})
+	}}
+}
+
+// Uniq appends a stage that forwards only the first occurrence of each
+// distinct element reaching it, compared via reflect.DeepEqual.
+func (s *Stream) Uniq() *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull := s.pull
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		var seen []interface{}
+		upstreamPull(func(obj interface{}) bool {
+			for _, o := range seen {
+				if reflect.DeepEqual(o, obj) {
+					return true
+				}
+			}
+
+			seen = append(seen, obj)
+			return sink(obj)
+		})
+	}}
+}
+
+// Sort appends a stage that buffers every element reaching it, sorts the
+// buffer with lessFunc, and then forwards the elements in order. Unlike the
+// other stages, Sort must materialize its input before it can emit anything.
+func (s *Stream) Sort(lessFunc LessFunc) *Stream {
+	if !s.valid {
+		return s
+	}
+
+	upstreamPull := s.pull
+	return &Stream{valid: true, pull: func(sink func(obj interface{}) bool) {
+		buffered := make([]interface{}, 0)
+		upstreamPull(func(obj interface{}) bool {
+			buffered = append(buffered, obj)
+			return true
+		})
+
+		sort.Slice(buffered, func(i, j int) bool { return lessFunc(buffered[i], buffered[j]) })
+
+		for _, obj := range buffered {
+			if !sink(obj) {
+				return
+			}
+		}
+	}}
+}
+
+// ToSlice materializes the stream into a new slice.
+// If the source is not a slice, then NotSliceErr is returned.
+func (s *Stream) ToSlice() ([]interface{}, error) {
+	if !s.valid {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	result := make([]interface{}, 0)
+	s.pull(func(obj interface{}) bool {
+		result = append(result, obj)
+		return true
+	})
+
+	return result, nil
+}
+
+// ForEach calls f once for each element reaching the end of the pipeline.
+// If the source is not a slice, then NotSliceErr is returned.
+func (s *Stream) ForEach(f func(obj interface{})) error {
+	if !s.valid {
+		return NotSliceErr
+	}
+
+	s.pull(func(obj interface{}) bool {
+		f(obj)
+		return true
+	})
+
+	return nil
+}
+
+// Reduce threads the accumulated value returned by the previous call to
+// reduceFunc (or initial, for the first call) through every element reaching
+// the end of the pipeline, and returns the final accumulated value.
+// If the source is not a slice, then NotSliceErr is returned.
+func (s *Stream) Reduce(initial interface{}, reduceFunc ReduceFunc) (interface{}, error) {
+	if !s.valid {
+		return nil, NotSliceErr
+	}
+
+	acc := initial
+	s.pull(func(obj interface{}) bool {
+		acc = reduceFunc(acc, obj)
+		return true
+	})
+
+	return acc, nil
+}
+
+// Count returns the number of elements reaching the end of the pipeline.
+// If the source is not a slice, then NotSliceErr is returned.
+func (s *Stream) Count() (int, error) {
+	if !s.valid {
+		return 0, NotSliceErr
+	}
+
+	count := 0
+	s.pull(func(obj interface{}) bool {
+		count++
+		return true
+	})
+
+	return count, nil
+}
+
+// First returns the first element reaching the end of the pipeline, stopping
+// the pipeline as soon as it arrives.
+// If the source is not a slice, then NotSliceErr is returned.
+// If no element reaches the end of the pipeline, then ElemNotFoundErr is returned.
+func (s *Stream) First() (interface{}, error) {
+	if !s.valid {
+		return nil, NotSliceErr
+	}
+
+	var first interface{}
+	found := false
+	s.pull(func(obj interface{}) bool {
+		first = obj
+		found = true
+		return false
+	})
+
+	if !found {
+		return nil, ElemNotFoundErr
+	}
+
+	return first, nil
+}
+
+// parallelStage fans f out across n goroutines over the elements pulled from
+// upstreamPull, and feeds the ones for which f reports keep=true into sink in
+// their original order.
+func parallelStage(upstreamPull func(func(obj interface{}) bool), n int, f func(obj interface{}) (val interface{}, keep bool), sink func(obj interface{}) bool) {
+	type job struct {
+		idx int
+		obj interface{}
+	}
+	type result struct {
+		idx  int
+		val  interface{}
+		keep bool
+	}
+
+	jobs := make(chan job, n)
+	results := make(chan result, n)
+	done := make(chan struct{})
+
+	var workers sync.WaitGroup
+	for i := 0; i < n; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for j := range jobs {
+				val, keep := f(j.obj)
+				results <- result{idx: j.idx, val: val, keep: keep}
+			}
+		}()
+	}
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(jobs)
+		idx := 0
+		upstreamPull(func(obj interface{}) bool {
+			select {
+			case jobs <- job{idx: idx, obj: obj}:
+				idx++
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	pending := make(map[int]result)
+	next := 0
+	stopped := false
+
+	for res := range results {
+		pending[res.idx] = res
+
+		for {
+			r, ok := pending[next]
+			if !ok {
+				break
+			}
+
+			delete(pending, next)
+			next++
+
+			if stopped {
+				continue
+			}
+
+			if r.keep && !sink(r.val) {
+				stopped = true
+				close(done)
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStreamToSlice(t *testing.T) {
+	got, err := From([]int{1, 2, 3}).ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+
+	if _, err := From("not a slice").ToSlice(); err != NotSliceErr {
+		t.Errorf("From(non-slice).ToSlice() error = %v, want NotSliceErr", err)
+	}
+}
+
+func TestStreamMapSelectFlatMap(t *testing.T) {
+	got, err := From([]int{1, 2, 3, 4}).
+		Select(func(obj interface{}) bool { return obj.(int)%2 == 0 }).
+		Map(func(obj interface{}) interface{} { return obj.(int) * 10 }).
+		ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{20, 40}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Select().Map() = %v, want %v", got, want)
+	}
+
+	got, err = From([]int{1, 2}).
+		FlatMap(func(obj interface{}) []interface{} { return []interface{}{obj, obj} }).
+		ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{1, 1, 2, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FlatMap() = %v, want %v", got, want)
+	}
+}
+
+func TestStreamTakeDrop(t *testing.T) {
+	got, err := From([]int{1, 2, 3, 4, 5}).Take(2).ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Take(2) = %v, want %v", got, want)
+	}
+
+	got, err = From([]int{1, 2, 3, 4, 5}).Drop(3).ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Drop(3) = %v, want %v", got, want)
+	}
+}
+
+func TestStreamUniqSort(t *testing.T) {
+	got, err := From([]int{3, 1, 2, 1, 3}).Uniq().ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Uniq() = %v, want %v", got, want)
+	}
+
+	got, err = From([]int{3, 1, 2}).Sort(func(a, b interface{}) bool { return a.(int) < b.(int) }).ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort() = %v, want %v", got, want)
+	}
+}
+
+func TestStreamForEachReduceCountFirst(t *testing.T) {
+	var seen []interface{}
+	if err := From([]int{1, 2, 3}).ForEach(func(obj interface{}) { seen = append(seen, obj) }); err != nil {
+		t.Fatalf("ForEach returned error: %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("ForEach visited %v, want %v", seen, want)
+	}
+
+	sum, err := From([]int{1, 2, 3}).Reduce(0, func(acc, obj interface{}) interface{} { return acc.(int) + obj.(int) })
+	if err != nil {
+		t.Fatalf("Reduce returned error: %v", err)
+	}
+	if sum != 6 {
+		t.Errorf("Reduce() = %v, want 6", sum)
+	}
+
+	count, err := From([]int{1, 2, 3}).Count()
+	if err != nil {
+		t.Fatalf("Count returned error: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Count() = %v, want 3", count)
+	}
+
+	first, err := From([]int{1, 2, 3}).First()
+	if err != nil {
+		t.Fatalf("First returned error: %v", err)
+	}
+	if first != 1 {
+		t.Errorf("First() = %v, want 1", first)
+	}
+
+	if _, err := From([]int{}).First(); err != ElemNotFoundErr {
+		t.Errorf("First(empty) error = %v, want ElemNotFoundErr", err)
+	}
+
+	if _, err := From("not a slice").Reduce(0, func(acc, obj interface{}) interface{} { return acc }); err != NotSliceErr {
+		t.Errorf("Reduce(non-slice) error = %v, want NotSliceErr", err)
+	}
+}
+
+// TestParallelStopsEarlyOnTake guards against a regression of the bug fixed
+// in parallelStage, where the fan-out producer kept pulling the entire
+// upstream even after Take had signalled the pipeline to stop.
+func TestParallelStopsEarlyOnTake(t *testing.T) {
+	const total = 10000
+	const k = 5
+	const parallelism = 4
+
+	large := make([]int, total)
+	for i := range large {
+		large[i] = i
+	}
+
+	var calls int32
+	mapFunc := func(obj interface{}) interface{} {
+		atomic.AddInt32(&calls, 1)
+		return obj
+	}
+
+	got, err := From(large).Parallel(parallelism).Map(mapFunc).Take(k).ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice returned error: %v", err)
+	}
+
+	if len(got) != k {
+		t.Fatalf("Take(%d) returned %d elements, want %d", k, len(got), k)
+	}
+
+	if max := int32(k + parallelism*4); atomic.LoadInt32(&calls) > max {
+		t.Errorf("mapFunc called %d times for a Take(%d) over %d elements, want at most %d: stop signal not propagated to the Parallel producer", calls, k, total, max)
+	}
+}